@@ -1,5 +1,10 @@
 package contracts
 
+import (
+	"context"
+	"time"
+)
+
 // Application 应用程序接口
 // application interface
 type Application interface {
@@ -26,16 +31,55 @@ type Application interface {
 	Environment() string
 
 	// RegisterServices 注册应用服务.
+	// DeferredServiceProvider 会推迟到其提供的键第一次被解析时再注册.
+	// 如果容器实现了 ContainerInspector，注册后会检测循环依赖，存在时以 CircularDependencyErrors 返回所有循环，服务仍然会被注册
 	// Register the application service.
-	RegisterServices(provider ...ServiceProvider)
+	// A DeferredServiceProvider is postponed until one of its keys is first resolved.
+	// If the container implements ContainerInspector, circular dependencies are detected after registration,
+	// and all cycles are returned as CircularDependencyErrors, the services are registered regardless.
+	RegisterServices(provider ...ServiceProvider) error
 
-	// Start 开启应用程序
-	// application start.
+	// Start 开启应用程序，服务按依赖关系拓扑排序后依次启动，返回以服务名称为键的启动错误.
+	// 启动前会根据 ConfigSchemaProvider 声明的约束校验配置，存在 ConfigSeverityError 级别的违规时以 StartConfigKey 为键返回 ConfigValidationError，
+	// ConfigSeverityWarning 级别的违规只记录日志
+	// application start, services are started in topological order of their dependencies,
+	// returning start errors keyed by service name.
+	// The configuration is validated against the constraints declared by ConfigSchemaProvider first,
+	// a ConfigValidationError is returned under StartConfigKey when there are ConfigSeverityError violations,
+	// ConfigSeverityWarning violations are only logged.
 	Start() map[string]error
 
-	// Stop 关闭应用程序
-	// application stop.
+	// Stop 关闭应用程序，服务按启动的逆序关闭
+	// application stop, services are stopped in reverse start order.
 	Stop()
+
+	// StopWithContext 在给定上下文的截止时间内优雅地关闭应用程序，返回每个服务的关闭结果
+	// Gracefully stop the application within the deadline of the given context,
+	// returning the shutdown result of each service.
+	StopWithContext(ctx context.Context) []ShutdownResult
+}
+
+// Start 返回值中的保留键，以 @ 开头，不会与服务名称冲突
+// reserved keys of the Start result, prefixed with @ so they never collide with service names.
+const (
+	StartConfigKey    = "@config"
+	StartProvidersKey = "@providers"
+)
+
+// ShutdownResult 服务提供者的关闭结果
+// shutdown result of a service provider.
+type ShutdownResult struct {
+	// Provider 服务提供者名称
+	// service provider name.
+	Provider string
+
+	// Duration 关闭耗时
+	// time taken to stop.
+	Duration time.Duration
+
+	// Err 关闭时发生的错误，超过截止时间时为 context.DeadlineExceeded
+	// error that occurred while stopping, context.DeadlineExceeded when the deadline is exceeded.
+	Err error
 }
 
 // ServiceProvider 服务提供者接口
@@ -53,3 +97,81 @@ type ServiceProvider interface {
 	// stop service.
 	Stop()
 }
+
+// GracefulServiceProvider 支持优雅关闭的服务提供者
+// Service provider that supports graceful shutdown.
+type GracefulServiceProvider interface {
+	ServiceProvider
+
+	// StopWithContext 在给定上下文的截止时间内关闭服务，等待进行中的工作完成
+	// stop the service within the deadline of the given context, waiting for in-flight work to finish.
+	StopWithContext(ctx context.Context) error
+}
+
+// DependentServiceProvider 声明依赖关系的服务提供者
+// Service provider that declares its dependencies.
+type DependentServiceProvider interface {
+	ServiceProvider
+
+	// Provides 获取该服务提供者提供的容器键
+	// Get the container keys provided by this service provider.
+	Provides() []string
+
+	// DependsOn 获取该服务提供者依赖的容器键.
+	// 依赖的键既没有服务提供者提供也没有在容器中绑定时，该服务不会启动，Start 以其名称为键返回 BindingMissing 的 BindingError；
+	// 服务提供者之间存在循环依赖时，循环中的服务都不会启动，Start 以 StartProvidersKey 为键返回 CircularDependencyErrors
+	// Get the container keys this service provider depends on.
+	// When a key is neither provided by any provider nor bound in the container, the provider is not started
+	// and Start returns a BindingError of kind BindingMissing under its name;
+	// when providers depend on each other in a cycle, none of them is started
+	// and Start returns CircularDependencyErrors under StartProvidersKey.
+	DependsOn() []string
+}
+
+// DeferredServiceProvider 延迟加载的服务提供者，
+// 只有在容器第一次解析其提供的键时才会注册并启动，从未启动过的服务不会传给 Stop 和 StopWithContext
+// Deferred service provider, registered and started only
+// the first time one of the keys it provides is resolved from the container,
+// a provider that was never started is not passed to Stop or StopWithContext.
+type DeferredServiceProvider interface {
+	ServiceProvider
+
+	// DeferredProvides 获取该服务提供者延迟提供的容器键，注册前 Container.HasBound 对这些键也返回 true.
+	// 同时实现 DependentServiceProvider 时仍然延迟加载，不参与启动时的拓扑排序，
+	// 第一次被解析时先解析其 DependsOn 中的键，再注册并启动
+	// Get the container keys deferred by this service provider,
+	// Container.HasBound reports true for these keys even before registration.
+	// A provider that also implements DependentServiceProvider is still deferred and left out of the start order,
+	// when first resolved the keys in its DependsOn are resolved before it is registered and started.
+	DeferredProvides() []string
+}
+
+// BootingServiceProvider 在所有服务启动前执行的服务提供者
+// Service provider executed before all services are started.
+type BootingServiceProvider interface {
+	ServiceProvider
+
+	// Booting 所有服务启动前调用
+	// called before all services are started.
+	Booting(application Application)
+}
+
+// BootedServiceProvider 在所有服务启动后执行的服务提供者
+// Service provider executed after all services are started.
+type BootedServiceProvider interface {
+	ServiceProvider
+
+	// Booted 所有服务启动后调用
+	// called after all services are started.
+	Booted(application Application)
+}
+
+// TerminatingServiceProvider 在所有服务关闭前执行的服务提供者
+// Service provider executed before all services are stopped.
+type TerminatingServiceProvider interface {
+	ServiceProvider
+
+	// Terminating 所有服务关闭前调用
+	// called before all services are stopped.
+	Terminating(application Application)
+}
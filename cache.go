@@ -18,6 +18,7 @@ type CacheFactory interface {
 }
 
 type CacheStore interface {
+	Measurable
 
 	// Get 按键从缓存中检索项目
 	// Retrieve an item from the cache by key.
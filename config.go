@@ -1,25 +1,265 @@
 package contracts
 
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
 type ConfigProvider func(env Env) any
 
+// ConfigLayer 配置源的层级，层级越高优先级越高
+// the layer of a configuration source, the higher the layer the higher the precedence.
+type ConfigLayer int
+
+const (
+	ConfigDefaultsLayer ConfigLayer = iota
+	ConfigFileLayer
+	ConfigEnvLayer
+	ConfigArgumentsLayer
+	ConfigRuntimeLayer
+)
+
+// ConfigSource 配置源
+// configuration source.
+type ConfigSource interface {
+	FieldsProvider
+
+	// Name 获取配置源名称
+	// get the configuration source name.
+	Name() string
+
+	// Layer 获取配置源所在的层级
+	// get the layer of the configuration source.
+	Layer() ConfigLayer
+}
+
+// WatchableConfigSource 可监听变化的配置源，例如文件或环境变量
+// configuration source that can watch for changes, such as files or environment variables.
+type WatchableConfigSource interface {
+	ConfigSource
+
+	// Watch 监听配置源的变化，变化时调用 onChange，直到上下文结束
+	// watch the source for changes and call onChange when it changes, until the context is done.
+	Watch(ctx context.Context, onChange func()) error
+}
+
+// ConfigWatcher 配置变化回调
+// configuration change callback.
+type ConfigWatcher func(old, new any)
+
+// ConfigChange 单个配置值的变化
+// change of a single configuration value.
+type ConfigChange struct {
+	Key string
+	Old any
+	New any
+}
+
+// ConfigChangedEvent ConfigChanged 事件的名称，即其 Event() 的返回值
+// name of the ConfigChanged event, the value returned by its Event().
+const ConfigChangedEvent = "config.changed"
+
+// ConfigChanged 配置重新加载后分发的事件
+// event dispatched after the configuration is reloaded.
+type ConfigChanged interface {
+	Event
+
+	// Changes 获取所有变化
+	// get all changes.
+	Changes() []ConfigChange
+}
+
+// ConfigValueType 配置值类型
+// configuration value type.
+type ConfigValueType string
+
+const (
+	ConfigString   ConfigValueType = "string"
+	ConfigInt      ConfigValueType = "int"
+	ConfigFloat    ConfigValueType = "float"
+	ConfigBool     ConfigValueType = "bool"
+	ConfigDuration ConfigValueType = "duration"
+	ConfigSlice    ConfigValueType = "slice"
+	ConfigMap      ConfigValueType = "map"
+)
+
+// ConfigKeySchema 单个配置键的约束
+// constraints of a single configuration key.
+type ConfigKeySchema struct {
+	Key         string
+	Type        ConfigValueType
+	Description string
+	Required    bool
+	Default     any
+	Min         *float64
+	Max         *float64
+	Enum        []any
+
+	// Deprecated 非空时表示该键已弃用，值为弃用说明
+	// a non-empty value marks the key as deprecated and explains why.
+	Deprecated string
+}
+
+// ConfigSchemaProvider 声明配置约束的服务提供者
+// Service provider that declares configuration constraints.
+type ConfigSchemaProvider interface {
+	ServiceProvider
+
+	// ConfigSchema 获取该服务提供者的配置约束
+	// Get the configuration constraints of this service provider.
+	ConfigSchema() []ConfigKeySchema
+}
+
+// ConfigSeverity 违规的严重程度
+// severity of a violation.
+type ConfigSeverity string
+
+const (
+	// ConfigSeverityError 违规会导致启动失败
+	// the violation fails the start.
+	ConfigSeverityError ConfigSeverity = "error"
+
+	// ConfigSeverityWarning 违规只会记录日志，例如使用了已弃用的键
+	// the violation is only logged, such as using a deprecated key.
+	ConfigSeverityWarning ConfigSeverity = "warning"
+)
+
+// ConfigViolation 配置约束违规
+// configuration constraint violation.
+type ConfigViolation struct {
+	Key      string
+	Message  string
+	Severity ConfigSeverity
+}
+
+// ConfigValidationError 配置校验失败时返回的错误，包含所有 ConfigSeverityError 级别的违规
+// error returned when configuration validation fails, containing all violations of ConfigSeverityError.
+type ConfigValidationError struct {
+	Violations []ConfigViolation
+}
+
+func (err ConfigValidationError) Error() string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		messages = append(messages, violation.Key+": "+violation.Message)
+	}
+	return "config: invalid configuration: " + strings.Join(messages, "; ")
+}
+
+func (err ConfigValidationError) GetPrevious() Exception {
+	return nil
+}
+
+// SecretPrefix 加密配置值的前缀，格式为 enc:{encryptor}:{payload}，省略 encryptor 时使用默认加密器
+// prefix of encrypted configuration values, in the form enc:{encryptor}:{payload},
+// the default encryptor is used when encryptor is omitted.
+const SecretPrefix = "enc:"
+
+// SecretRedacted 脱敏后的占位值
+// placeholder value for redacted secrets.
+const SecretRedacted = "******"
+
+// SecretResolver 配置中加密值的解析器，通过 EncryptorFactory 解密
+// resolver of encrypted configuration values, decrypting through the EncryptorFactory.
+type SecretResolver interface {
+	// IsSecret 判断给定值是否为加密值
+	// Determine whether the given value is encrypted.
+	IsSecret(value string) bool
+
+	// Resolve 解密给定的加密值
+	// decrypt the given encrypted value.
+	Resolve(value string) (string, error)
+
+	// Encrypt 使用给定名称的加密器加密值，并添加 SecretPrefix
+	// encrypt the value with the named encryptor and add the SecretPrefix.
+	Encrypt(encryptor string, value string) string
+
+	// Rotate 使用给定名称的新加密器重新加密给定的加密值
+	// re-encrypt the given encrypted value with the named new encryptor.
+	Rotate(encryptor string, value string) (string, error)
+}
+
+// ConfigProvenance 配置值的来源
+// the provenance of a configuration value.
+type ConfigProvenance struct {
+	Key    string
+	Source string
+	Layer  ConfigLayer
+}
+
 type Config interface {
 	Getter[any]
+	OptionalGetter[any]
 	FieldsProvider
 
-	// Load 根据给定的字段提供者加载配置
-	// load configuration based on given field provider.
+	// Load 根据给定的字段提供者加载配置，写入 ConfigFileLayer 层级
+	// load configuration based on given field provider, written into the ConfigFileLayer.
 	Load(provider FieldsProvider)
 
-	// Reload 根据给定的字段提供者加载配置
-	// reload configuration based on given field provider.
+	// Reload 根据给定的字段提供者加载配置，计算差异并通过 EventDispatcher 分发 ConfigChanged 事件
+	// reload configuration based on given field provider,
+	// compute the diff and dispatch a ConfigChanged event through the EventDispatcher.
 	Reload()
 
-	// Set 设置给定的配置值
-	// set a given configuration value.
+	// UseDispatcher 使用给定的事件调度器分发 ConfigChanged 事件，为 nil 时只通知 Watch 的回调
+	// use the given event dispatcher for ConfigChanged events, only Watch callbacks are notified when nil.
+	UseDispatcher(dispatcher EventDispatcher)
+
+	// Watch 监听给定键的变化，子键变化时也会触发，例如监听 database 时 database.host 的变化，
+	// 此时 old 和 new 为整个 database 的值；返回取消监听的函数
+	// watch for changes of the given key, also fired when a nested key changes, e.g. database.host when watching database,
+	// in which case old and new are the values of the whole database subtree; returns a function that cancels the watch.
+	Watch(key string, watcher ConfigWatcher) func()
+
+	// AddSource 添加配置源，同一层级中后添加的配置源优先
+	// add a configuration source, later sources take precedence within the same layer.
+	AddSource(source ConfigSource)
+
+	// Bind 将给定前缀下的配置绑定到结构体，支持 `config:"name"`、`default:"value"` 和 `required:"true"` 标签，
+	// 缺少必填字段时返回 ConfigValidationError，包含所有缺失的键；
+	// 带 SecretPrefix 的值会通过 UseSecrets 设置的解析器解密后再绑定
+	// bind the configuration under the given prefix to a struct,
+	// supports `config:"name"`, `default:"value"` and `required:"true"` tags,
+	// missing required fields return a ConfigValidationError listing every missing key;
+	// values with the SecretPrefix are decrypted by the resolver given to UseSecrets before binding.
+	Bind(prefix string, dest any) error
+
+	// Provenance 获取给定配置值的来源
+	// get the provenance of the given configuration value.
+	Provenance(key string) (ConfigProvenance, bool)
+
+	// RegisterSchema 注册配置约束
+	// register configuration constraints.
+	RegisterSchema(schemas ...ConfigKeySchema)
+
+	// Schemas 获取所有已注册的配置约束
+	// get all registered configuration constraints.
+	Schemas() []ConfigKeySchema
+
+	// Validate 根据已注册的配置约束校验整个配置，返回所有违规，已弃用的键以 ConfigSeverityWarning 返回
+	// validate the whole configuration against the registered constraints, returning all violations,
+	// deprecated keys are returned with ConfigSeverityWarning.
+	Validate() []ConfigViolation
+
+	// UseSecrets 使用给定的解析器，通过 Getter 读取的标量加密值以及 Bind 绑定的加密值会被透明解密；
+	// GetFields 和 Fields 返回的值中加密值保持密文，可以安全地输出和记录日志
+	// use the given resolver, encrypted scalar values read through the Getter and encrypted values bound by Bind
+	// are decrypted transparently; encrypted values returned by GetFields and Fields stay encrypted,
+	// so they are safe to dump and log.
+	UseSecrets(resolver SecretResolver)
+
+	// Redacted 获取脱敏后的所有配置，加密值会被替换为 SecretRedacted，用于输出和日志
+	// get all configuration with secrets replaced by SecretRedacted, for dumps and logs.
+	Redacted() Fields
+
+	// Set 在运行时层级设置给定的配置值，值发生变化时与 Reload 一样触发 Watch 的回调并分发 ConfigChanged 事件
+	// set a given configuration value in the runtime layer, when the value changes
+	// Watch callbacks are fired and a ConfigChanged event is dispatched, as with Reload.
 	Set(key string, value any)
 
-	// Unset 销毁指定的配置值
-	// Destroy the specified configuration value.
+	// Unset 销毁指定的配置值，与 Set 一样触发回调和事件
+	// Destroy the specified configuration value, firing callbacks and events as Set does.
 	Unset(key string)
 }
 
@@ -29,7 +269,67 @@ type Env interface {
 
 	FieldsProvider
 
-	// Load 加载配置
-	// load configuration.
-	Load() Fields
+	// UseSecrets 使用给定的解析器，通过 Getter 读取的标量加密值会被透明解密；
+	// GetFields 和 Fields 返回的值中加密值保持密文，可以安全地输出和记录日志
+	// use the given resolver, encrypted scalar values read through the Getter are decrypted transparently;
+	// encrypted values returned by GetFields and Fields stay encrypted, so they are safe to dump and log.
+	UseSecrets(resolver SecretResolver)
+
+	// Load 加载配置，失败时返回已加载的部分和错误，例如带行号的 EnvSyntaxError
+	// load configuration, returning what was loaded and the error on failure,
+	// such as an EnvSyntaxError with line numbers.
+	Load() (Fields, error)
+
+	// Redacted 获取脱敏后的所有环境变量，加密值会被替换为 SecretRedacted，用于输出和日志
+	// get all environment variables with secrets replaced by SecretRedacted, for dumps and logs.
+	Redacted() Fields
+
+	// Files 获取实际读取的环境变量文件，按优先级从低到高排列
+	// get the env files actually read, ordered from lowest to highest precedence.
+	Files() []string
+}
+
+// EnvKey 当前运行环境的环境变量名称
+// name of the environment variable holding the current operating environment.
+const EnvKey = "APP_ENV"
+
+// EnvLoader 环境变量文件加载器.
+// 按 .env、.env.{environment}、.env.local 的顺序加载，后者覆盖前者，
+// environment 为空时使用 EnvKey 的值，先从系统环境变量读取，其次从 .env 读取，该值也是 Application.Environment() 的返回值.
+// 系统环境变量优先于所有文件中的值，并且可以在 ${VAR} 插值中使用.
+// 支持 export 前缀、多行引号值、${VAR} 插值以及 ${VAR:-default} 默认值
+// env file loader.
+// Files are loaded in the order .env, .env.{environment}, .env.local, later files override earlier ones,
+// when environment is empty the value of EnvKey is used, read from the OS environment first and then from .env,
+// it is also the value returned by Application.Environment().
+// OS environment variables take precedence over values from all files and are available for ${VAR} interpolation.
+// Supports the export prefix, multiline quoted values, ${VAR} interpolation and ${VAR:-default} defaults.
+type EnvLoader interface {
+	// Files 获取给定环境下需要加载的文件，按优先级从低到高排列
+	// get the files to load for the given environment, ordered from lowest to highest precedence.
+	Files(environment string) []string
+
+	// Parse 解析给定的文件，语法错误时返回 EnvSyntaxError
+	// parse the given file, returning EnvSyntaxError on syntax errors.
+	Parse(path string) (Fields, error)
+
+	// Load 加载给定环境下的所有文件并完成插值
+	// load all files for the given environment and perform interpolation.
+	Load(environment string) (Fields, error)
+}
+
+// EnvSyntaxError 环境变量文件语法错误
+// env file syntax error.
+type EnvSyntaxError struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (err EnvSyntaxError) Error() string {
+	return fmt.Sprintf("env: %s:%d: %s", err.File, err.Line, err.Message)
+}
+
+func (err EnvSyntaxError) GetPrevious() Exception {
+	return nil
 }
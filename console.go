@@ -133,8 +133,8 @@ type Schedule interface {
 }
 
 type ScheduleEvent interface {
-	// Run 运行给定的事件
-	// run the given event.
+	// Run 运行给定的事件，panic 会被 RecoveryBoundary 捕获
+	// run the given event, panics are caught by the RecoveryBoundary.
 	Run(application Application)
 
 	// WithoutOverlapping 不允许事件相互重叠
@@ -1,6 +1,10 @@
 package contracts
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
 
 // InstanceProvider 容器实例提供者
 // container instance provider
@@ -20,8 +24,18 @@ type Container interface {
 	// Register a shared binding in the container.
 	Singleton(string, any)
 
-	// HasBound 判断是否绑定
-	// Determine whether to bind.
+	// Scoped 在容器中注册一个作用域绑定，每个作用域内只解析一次；
+	// 在作用域之外（根容器）解析时以 BindingOutOfScope 的 BindingError panic
+	// Register a scoped binding that is resolved once per scope;
+	// resolving it outside a scope (from the root container) panics with a BindingError of kind BindingOutOfScope.
+	Scoped(string, any)
+
+	// Scope 创建一个子容器作为新的作用域
+	// Create a child container as a new scope.
+	Scope() ScopedContainer
+
+	// HasBound 判断是否绑定，DeferredServiceProvider 声明的键在注册前也视为已绑定
+	// Determine whether to bind, keys declared by a DeferredServiceProvider count as bound before registration.
 	HasBound(string) bool
 
 	// Alias 将类型别名为不同的名称
@@ -49,6 +63,58 @@ type Container interface {
 	DI(object any, args ...any)
 }
 
+// ScopedContainer 作用域容器，通常对应一个请求或者一个任务.
+// Router 为每个请求创建作用域，通过 HttpRequest.Scope() 获取，响应完成后释放；
+// QueueWorker 为每个作业创建作用域，作业实现 Component 时在 Handle 前以该作用域调用 Construct，Handle 返回后释放
+// scoped container, usually corresponds to a request or a job.
+// The Router creates a scope per request, available through HttpRequest.Scope() and disposed after the response;
+// the QueueWorker creates a scope per job, calls Construct with it before Handle when the job implements Component,
+// and disposes it after Handle returns.
+type ScopedContainer interface {
+	Container
+
+	// Parent 获取父容器
+	// get the parent container.
+	Parent() Container
+
+	// OnDispose 注册作用域结束时执行的回调
+	// Register a callback to be executed when the scope ends.
+	OnDispose(callback func() error)
+
+	// Dispose 结束作用域，按注册或解析的逆序执行所有回调并释放作用域内解析的 Disposable 实例，
+	// 某个回调失败不会影响其他回调，所有错误以 DisposeError 返回
+	// end the scope, executing all callbacks and releasing the Disposable instances resolved in the scope
+	// in reverse order of registration or resolution, a failing callback does not stop the others,
+	// all errors are returned as a DisposeError.
+	Dispose() error
+}
+
+// Disposable 可释放的实例，作用域结束时会被调用
+// Disposable instance, will be called when the scope ends.
+type Disposable interface {
+	// Dispose 释放资源，例如提交或回滚事务
+	// release resources, such as committing or rolling back a transaction.
+	Dispose() error
+}
+
+// DisposeError 作用域释放时发生的所有错误，按发生顺序排列
+// all errors that occurred while disposing a scope, in the order they occurred.
+type DisposeError struct {
+	Errors []error
+}
+
+func (err DisposeError) Error() string {
+	messages := make([]string, 0, len(err.Errors))
+	for _, e := range err.Errors {
+		messages = append(messages, e.Error())
+	}
+	return "container: dispose scope: " + strings.Join(messages, "; ")
+}
+
+func (err DisposeError) GetPrevious() Exception {
+	return nil
+}
+
 // Component 可注入的类
 // injectable class.
 type Component interface {
@@ -80,3 +146,212 @@ type MagicalFunc interface {
 	// get all return types.
 	Returns() []reflect.Type
 }
+
+// BindingLifetime 绑定的生命周期
+// binding lifetime.
+type BindingLifetime string
+
+const (
+	LifetimeBind      BindingLifetime = "bind"
+	LifetimeSingleton BindingLifetime = "singleton"
+	LifetimeScoped    BindingLifetime = "scoped"
+	LifetimeInstance  BindingLifetime = "instance"
+	LifetimeAlias     BindingLifetime = "alias"
+)
+
+// BindingInfo 容器中绑定的描述信息
+// description of a binding in the container.
+type BindingInfo struct {
+	// Key 绑定的键
+	// the key of the binding.
+	Key string `json:"key"`
+
+	// Lifetime 绑定的生命周期
+	// the lifetime of the binding.
+	Lifetime BindingLifetime `json:"lifetime"`
+
+	// Target 别名指向的键，仅在 LifetimeAlias 时有值
+	// the key the alias points to, only set for LifetimeAlias.
+	Target string `json:"target,omitempty"`
+
+	// Dependencies 由 MagicalFunc.Arguments() 推导出的依赖键
+	// dependency keys derived from MagicalFunc.Arguments().
+	Dependencies []string `json:"dependencies"`
+
+	// Resolved 是否已经被解析过
+	// whether it has been resolved.
+	Resolved bool `json:"resolved"`
+}
+
+// ContainerInspector 容器依赖图检查器
+// container dependency graph inspector.
+type ContainerInspector interface {
+	// Bindings 获取所有绑定
+	// get all bindings.
+	Bindings() []BindingInfo
+
+	// Binding 获取给定键的绑定
+	// get the binding for the given key.
+	Binding(key string) (BindingInfo, bool)
+
+	// DetectCycles 检测依赖图中的所有循环依赖
+	// detect all circular dependencies in the dependency graph.
+	DetectCycles() []CircularDependencyError
+
+	// DOT 以 graphviz DOT 格式导出依赖图
+	// export the dependency graph in graphviz DOT format.
+	DOT() string
+
+	// JSON 以 JSON 格式导出依赖图
+	// export the dependency graph in JSON format.
+	JSON() ([]byte, error)
+}
+
+// CircularDependencyError 检测到循环依赖时返回的错误
+// error returned when a circular dependency is detected.
+type CircularDependencyError struct {
+	// Chain 完整的依赖链，首尾是同一个键
+	// the full dependency chain, starting and ending with the same key.
+	Chain []string
+}
+
+func (err CircularDependencyError) Error() string {
+	return "container: circular dependency detected: " + strings.Join(err.Chain, " -> ")
+}
+
+func (err CircularDependencyError) GetPrevious() Exception {
+	return nil
+}
+
+// CircularDependencyErrors 检测到的所有循环依赖
+// all detected circular dependencies.
+type CircularDependencyErrors []CircularDependencyError
+
+func (errs CircularDependencyErrors) Error() string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (errs CircularDependencyErrors) GetPrevious() Exception {
+	return nil
+}
+
+// BindingErrorKind 绑定错误类型
+// binding error kind.
+type BindingErrorKind string
+
+const (
+	// BindingMissing 容器中不存在给定的绑定
+	// the given binding does not exist in the container.
+	BindingMissing BindingErrorKind = "missing"
+
+	// BindingMismatched 解析出的实例与期望的类型不匹配
+	// the resolved instance does not match the expected type.
+	BindingMismatched BindingErrorKind = "mismatched"
+
+	// BindingOutOfScope 在作用域之外解析作用域绑定
+	// a scoped binding was resolved outside a scope.
+	BindingOutOfScope BindingErrorKind = "out of scope"
+)
+
+// BindingError 容器解析失败时返回的错误
+// error returned when the container fails to resolve.
+type BindingError struct {
+	Kind     BindingErrorKind
+	Key      string
+	Expected reflect.Type
+	Actual   reflect.Type
+}
+
+func (err BindingError) Error() string {
+	switch err.Kind {
+	case BindingMismatched:
+		return fmt.Sprintf("container: binding %s is %v, expected %v", err.Key, err.Actual, err.Expected)
+	case BindingOutOfScope:
+		return fmt.Sprintf("container: scoped binding %s resolved outside a scope", err.Key)
+	}
+	return fmt.Sprintf("container: binding %s is missing, expected %v", err.Key, err.Expected)
+}
+
+func (err BindingError) GetPrevious() Exception {
+	return nil
+}
+
+// TypeKey 获取给定类型在容器中的键，指针类型会被去掉指针，
+// 所以 Provide[Foo] 与 Provide[*Foo] 共享同一个键并相互覆盖
+// Get the key of the given type in the container, pointer types are dereferenced,
+// so Provide[Foo] and Provide[*Foo] share a key and overwrite each other.
+func TypeKey(typ reflect.Type) string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if pkgPath := typ.PkgPath(); pkgPath != "" {
+		return pkgPath + "." + typ.Name()
+	}
+	return typ.String()
+}
+
+// TypeKeyOf 获取泛型类型在容器中的键
+// Get the key of the generic type in the container.
+func TypeKeyOf[T any]() string {
+	return TypeKey(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// Provide 以类型为键向容器注册绑定
+// Register a binding keyed by type with the container.
+func Provide[T any](c Container, provider InstanceProvider[T]) {
+	c.Bind(TypeKeyOf[T](), provider)
+}
+
+// ProvideSingleton 以类型为键在容器中注册一个共享绑定
+// Register a shared binding keyed by type in the container.
+func ProvideSingleton[T any](c Container, provider InstanceProvider[T]) {
+	c.Singleton(TypeKeyOf[T](), provider)
+}
+
+// Resolve 以类型为键从容器中解析实例
+// Resolve an instance keyed by type from the container.
+func Resolve[T any](c Container, args ...any) (T, error) {
+	return ResolveKey[T](c, TypeKeyOf[T](), args...)
+}
+
+// ResolveKey 通过给定的键或别名从容器中解析指定类型的实例，容器以 BindingError panic 时将其作为错误返回
+// Resolve an instance of the given type from the container by key or alias,
+// a BindingError panic from the container is returned as the error.
+func ResolveKey[T any](c Container, key string, args ...any) (instance T, err error) {
+	var zero T
+	expected := reflect.TypeOf((*T)(nil)).Elem()
+	if !c.HasBound(key) {
+		return zero, BindingError{Kind: BindingMissing, Key: key, Expected: expected}
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			bindingErr, ok := recovered.(BindingError)
+			if !ok {
+				panic(recovered)
+			}
+			if bindingErr.Expected == nil {
+				bindingErr.Expected = expected
+			}
+			instance, err = zero, bindingErr
+		}
+	}()
+	value := c.Get(key, args...)
+	if instance, ok := value.(T); ok {
+		return instance, nil
+	}
+	return zero, BindingError{Kind: BindingMismatched, Key: key, Expected: expected, Actual: reflect.TypeOf(value)}
+}
+
+// MustResolve 以类型为键从容器中解析实例，失败时 panic
+// Resolve an instance keyed by type from the container, panic on failure.
+func MustResolve[T any](c Container, args ...any) T {
+	instance, err := Resolve[T](c, args...)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
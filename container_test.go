@@ -0,0 +1,158 @@
+package contracts
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type fakeContainer struct {
+	bindings map[string]any
+	aliases  map[string]string
+	scoped   map[string]bool
+}
+
+func newFakeContainer() *fakeContainer {
+	return &fakeContainer{bindings: map[string]any{}, aliases: map[string]string{}, scoped: map[string]bool{}}
+}
+
+func (c *fakeContainer) key(key string) string {
+	if alias, ok := c.aliases[key]; ok {
+		return alias
+	}
+	return key
+}
+
+func (c *fakeContainer) Bind(key string, provider any)      { c.bindings[key] = provider }
+func (c *fakeContainer) Instance(key string, instance any)  { c.bindings[key] = instance }
+func (c *fakeContainer) Singleton(key string, provider any) { c.bindings[key] = provider }
+func (c *fakeContainer) Scoped(key string, provider any) {
+	c.bindings[key] = provider
+	c.scoped[key] = true
+}
+func (c *fakeContainer) Scope() ScopedContainer         { return nil }
+func (c *fakeContainer) Alias(key string, alias string) { c.aliases[alias] = key }
+func (c *fakeContainer) Flush()                         { c.bindings = map[string]any{} }
+func (c *fakeContainer) Call(fn any, args ...any) []any { return nil }
+func (c *fakeContainer) StaticCall(fn MagicalFunc, args ...any) []any {
+	return nil
+}
+func (c *fakeContainer) DI(object any, args ...any) {}
+
+func (c *fakeContainer) HasBound(key string) bool {
+	_, ok := c.bindings[c.key(key)]
+	return ok
+}
+
+func (c *fakeContainer) Get(key string, args ...any) any {
+	if c.scoped[c.key(key)] {
+		panic(BindingError{Kind: BindingOutOfScope, Key: key})
+	}
+	value := c.bindings[c.key(key)]
+	if provider := reflect.ValueOf(value); provider.Kind() == reflect.Func {
+		return provider.Call(nil)[0].Interface()
+	}
+	return value
+}
+
+type fakeService struct {
+	name string
+}
+
+func TestTypeKey(t *testing.T) {
+	if TypeKeyOf[fakeService]() != TypeKeyOf[*fakeService]() {
+		t.Fatalf("pointer and value keys differ: %s, %s", TypeKeyOf[fakeService](), TypeKeyOf[*fakeService]())
+	}
+	if key := TypeKeyOf[fakeService](); key != "github.com/goal-web/contracts.fakeService" {
+		t.Fatalf("unexpected key %s", key)
+	}
+	if key := TypeKeyOf[[]string](); key != "[]string" {
+		t.Fatalf("unexpected key %s", key)
+	}
+}
+
+func TestResolveMissing(t *testing.T) {
+	_, err := Resolve[*fakeService](newFakeContainer())
+
+	var bindingErr BindingError
+	if !errors.As(err, &bindingErr) || bindingErr.Kind != BindingMissing {
+		t.Fatalf("expected missing binding error, got %v", err)
+	}
+}
+
+func TestResolveMismatched(t *testing.T) {
+	container := newFakeContainer()
+	container.Instance(TypeKeyOf[fakeService](), "not a service")
+
+	_, err := Resolve[*fakeService](container)
+
+	var bindingErr BindingError
+	if !errors.As(err, &bindingErr) || bindingErr.Kind != BindingMismatched {
+		t.Fatalf("expected mismatched binding error, got %v", err)
+	}
+	if bindingErr.Actual != reflect.TypeOf("") {
+		t.Fatalf("unexpected actual type %v", bindingErr.Actual)
+	}
+}
+
+func TestResolvePointerAndValue(t *testing.T) {
+	container := newFakeContainer()
+	Provide[*fakeService](container, func() *fakeService {
+		return &fakeService{name: "pointer"}
+	})
+
+	service, err := Resolve[*fakeService](container)
+	if err != nil || service.name != "pointer" {
+		t.Fatalf("unexpected result %v, %v", service, err)
+	}
+
+	Provide[fakeService](container, func() fakeService {
+		return fakeService{name: "value"}
+	})
+
+	if _, err = Resolve[*fakeService](container); err == nil {
+		t.Fatalf("expected the value binding to overwrite the pointer binding")
+	}
+	if value := MustResolve[fakeService](container); value.name != "value" {
+		t.Fatalf("unexpected value %v", value)
+	}
+}
+
+func TestResolveKeyAlias(t *testing.T) {
+	container := newFakeContainer()
+	Provide[*fakeService](container, func() *fakeService {
+		return &fakeService{name: "aliased"}
+	})
+	container.Alias(TypeKeyOf[fakeService](), "service")
+
+	service, err := ResolveKey[*fakeService](container, "service")
+	if err != nil || service.name != "aliased" {
+		t.Fatalf("unexpected result %v, %v", service, err)
+	}
+}
+
+func TestMustResolvePanics(t *testing.T) {
+	defer func() {
+		if _, ok := recover().(BindingError); !ok {
+			t.Fatalf("expected MustResolve to panic with BindingError")
+		}
+	}()
+	MustResolve[*fakeService](newFakeContainer())
+}
+
+func TestResolveOutOfScope(t *testing.T) {
+	container := newFakeContainer()
+	container.Scoped(TypeKeyOf[fakeService](), func() *fakeService {
+		return &fakeService{name: "scoped"}
+	})
+
+	_, err := Resolve[*fakeService](container)
+
+	var bindingErr BindingError
+	if !errors.As(err, &bindingErr) || bindingErr.Kind != BindingOutOfScope {
+		t.Fatalf("expected out of scope binding error, got %v", err)
+	}
+	if bindingErr.Expected != reflect.TypeOf(&fakeService{}) {
+		t.Fatalf("unexpected expected type %v", bindingErr.Expected)
+	}
+}
@@ -1,5 +1,7 @@
 package contracts
 
+import "context"
+
 // DBConnector 获取数据库连接实例
 // Get a database connection instance.
 type DBConnector func(config Fields, dispatcher EventDispatcher) DBConnection
@@ -33,9 +35,17 @@ type DBTx interface {
 	// Rollback 回滚活动的数据库事务
 	// rollback the active database transaction.
 	Rollback() Exception
+
+	// AfterCommit 注册事务提交后执行的回调，事务回滚时不会执行
+	// register a callback executed after the transaction commits, skipped when it rolls back.
+	AfterCommit(callback func())
 }
 
 type SqlExecutor interface {
+	// WithContext 获取在给定上下文中执行的执行器，查询会作为上下文中 span 的子 span 记录
+	// get an executor running within the given context, queries are recorded as children of the span in the context.
+	WithContext(ctx context.Context) SqlExecutor
+
 	// Query 对连接执行新查询
 	// Execute a new query against the connection.
 	Query(query string, args ...any) (Collection[Fields], Exception)
@@ -55,13 +65,19 @@ type SqlExecutor interface {
 
 type DBConnection interface {
 	SqlExecutor
+	Pingable
+	Traceable
+	Measurable
 
 	// Begin 开始一个新的数据库事务。
 	// Start a new database transaction.
 	Begin() (DBTx, Exception)
 
-	// Transaction 在事务中执行闭包
-	// Execute a Closure within a transaction.
+	// Transaction 在事务中执行闭包，闭包中通过 EventDispatcher 分发的事件会立即触发且不会随回滚撤销，
+	// 需要与事务一致的事件应通过 Outbox.Dispatcher(executor) 分发
+	// Execute a Closure within a transaction, events dispatched through the EventDispatcher inside the closure
+	// fire immediately and are not undone by a rollback,
+	// events that must follow the transaction should be dispatched through Outbox.Dispatcher(executor).
 	Transaction(func(executor SqlExecutor) Exception) Exception
 
 	// DriverName 获取驱动程序名称
@@ -1,5 +1,7 @@
 package contracts
 
+import "context"
+
 type Event interface {
 	// Event 获取事件名称
 	// get event name.
@@ -13,18 +15,216 @@ type SyncEvent interface {
 	Sync() bool
 }
 
+// StoppableEvent 可以停止传播的事件，监听器调用 StopPropagation 后后续监听器不再执行
+// event whose propagation can be stopped, remaining listeners are skipped after a listener calls StopPropagation.
+type StoppableEvent interface {
+	Event
+
+	// StopPropagation 停止传播
+	// stop propagation.
+	StopPropagation()
+
+	// IsPropagationStopped 判断是否已停止传播
+	// Determine whether propagation has been stopped.
+	IsPropagationStopped() bool
+}
+
 type EventListener interface {
-	// Handle 事件触发时处理事件，所有监听器都会
-	// handle the event when the event is triggered, all listeners will
+	// Handle 事件触发时处理事件，panic 会被 RecoveryBoundary 捕获，不会影响其他监听器
+	// handle the event when it is triggered, panics are caught by the RecoveryBoundary and do not affect other listeners.
 	Handle(event Event)
 }
 
+// PrioritizedEventListener 带优先级的事件监听器，优先级高的先执行，默认优先级为 0
+// event listener with a priority, higher priorities run first, the default priority is 0.
+type PrioritizedEventListener interface {
+	EventListener
+
+	// Priority 获取优先级
+	// get priority.
+	Priority() int
+}
+
+// EventSubscriber 事件订阅者，一次注册多个监听器
+// event subscriber, registers many listeners at once.
+type EventSubscriber interface {
+	// Subscribe 向调度程序注册监听器
+	// register listeners with the dispatcher.
+	Subscribe(dispatcher EventDispatcher)
+}
+
+// QueuedEventListener 排队的事件监听器，ShouldQueue 返回 true 时事件与监听器会被序列化为 Job
+// 并推送到指定的队列连接.
+// 事件和监听器都需要注册到 ClassSerializer，监听器以其导出字段序列化；
+// worker 通过 ClassSerializer.Parse 重建监听器，再通过 Container.DI 注入依赖后调用 Handle 或 Failed
+// queued event listener, when ShouldQueue returns true the event and listener are serialized into a Job
+// and pushed onto the given queue connection.
+// Both the event and the listener must be registered with the ClassSerializer, the listener is serialized
+// by its exported fields; the worker rebuilds it with ClassSerializer.Parse and injects its dependencies
+// through Container.DI before calling Handle or Failed.
+type QueuedEventListener interface {
+	EventListener
+	ShouldQueue
+
+	// ViaConnection 获取队列连接名称，为空时使用默认连接
+	// get the queue connection name, the default connection is used when empty.
+	ViaConnection() string
+
+	// ViaQueue 获取队列名称，为空时使用默认队列
+	// get the queue name, the default queue is used when empty.
+	ViaQueue() string
+
+	// GetMaxTries 获取尝试的最大次数
+	// Get the max number of times to attempt.
+	GetMaxTries() int
+
+	// GetRetryInterval 获取重试的时间间隔，以秒为单位
+	// Get the retry interval, in seconds.
+	GetRetryInterval() int
+
+	// Failed 所有尝试都失败后调用
+	// called after all attempts have failed.
+	Failed(event Event, err error)
+}
+
+// AsyncEventListener 异步事件监听器，在进程内的有界协程池中执行
+// asynchronous event listener, executed in a bounded in-process worker pool.
+type AsyncEventListener interface {
+	EventListener
+
+	// Async 判断是否异步执行
+	// Determine whether to execute asynchronously.
+	Async() bool
+}
+
 type EventDispatcher interface {
-	// Register 向调度程序注册事件侦听器
-	// Register an event listener with the dispatcher.
-	Register(name string, listener EventListener)
+	// Register 向调度程序注册事件侦听器，返回移除该侦听器的函数.
+	// name 支持通配符，* 只匹配一个以 . 分隔的段，例如 user.* 匹配 user.created 但不匹配 user.profile.updated；
+	// ** 匹配一个或多个段，单独的 ** 匹配所有事件.
+	// 精确匹配和通配符匹配的侦听器统一按优先级从高到低执行，优先级相同时精确匹配在前，其次按注册顺序
+	// Register an event listener with the dispatcher, returning a function that removes it.
+	// name supports wildcards, * matches exactly one dot-separated segment,
+	// e.g. user.* matches user.created but not user.profile.updated;
+	// ** matches one or more segments, ** alone matches every event.
+	// Exact and wildcard listeners run together from the highest priority to the lowest,
+	// with exact listeners first on equal priority, then in registration order.
+	Register(name string, listener EventListener) func()
+
+	// Unregister 移除以给定名称注册的所有事件侦听器，不影响其他通配符匹配的侦听器
+	// Remove all event listeners registered under the given name, listeners of other matching wildcards are kept.
+	Unregister(name string)
+
+	// HasListeners 判断给定事件是否有侦听器，包括通配符匹配的侦听器
+	// Determine whether the given event has listeners, including wildcard matches.
+	HasListeners(name string) bool
+
+	// Subscribe 注册事件订阅者
+	// register event subscribers.
+	Subscribe(subscribers ...EventSubscriber)
 
-	// Dispatch 为所有侦听器提供要处理的事件
-	// Provide all listeners with an event to process.
+	// UseBus 使用给定的分布式事件总线，通过 Dispatch 分发的 BroadcastEvent 会同时发布到总线
+	// use the given distributed event bus, BroadcastEvent dispatched through Dispatch is also published to the bus.
+	UseBus(bus EventBus)
+
+	// DispatchLocal 只分发给本地侦听器，不会发布到总线
+	// Provide only local listeners with the event, it is never published to the bus.
+	DispatchLocal(event Event)
+
+	// Dispatch 为所有侦听器提供要处理的事件，QueuedEventListener 会被推送到队列，AsyncEventListener 会被异步执行
+	// Provide all listeners with an event to process,
+	// QueuedEventListener is pushed onto the queue and AsyncEventListener is executed asynchronously.
 	Dispatch(event Event)
 }
+
+// BroadcastEvent 需要广播到所有实例的事件
+// event that should be broadcast to all instances.
+type BroadcastEvent interface {
+	Event
+
+	// ShouldBroadcast 判断是否广播
+	// Determine whether to broadcast.
+	ShouldBroadcast() bool
+}
+
+// EventDelivery 分布式事件的送达保证
+// delivery guarantee of distributed events.
+type EventDelivery string
+
+const (
+	// AtMostOnce 通过 RedisConnection.Publish 发布，离线实例会错过事件
+	// published through RedisConnection.Publish, offline instances miss events.
+	AtMostOnce EventDelivery = "at-most-once"
+
+	// AtLeastOnce 通过 Redis stream 发布，确认前会重复投递；每个实例使用以 ConsumerGroup() 命名的独立消费组，
+	// 否则一条消息只会投递给组内的一个实例，事件不会被广播；消费组名称在重启后必须保持不变，
+	// 否则重启前未确认的消息会丢失，旧的消费组也会一直残留
+	// published through a Redis stream, redelivered until acknowledged; every instance uses its own consumer group
+	// named after ConsumerGroup(), otherwise a message is delivered to only one instance of the group and not broadcast;
+	// the group name must survive restarts, otherwise messages left unacknowledged before a restart are lost
+	// and stale groups pile up.
+	AtLeastOnce EventDelivery = "at-least-once"
+)
+
+// EventEnvelope 在实例之间传输的事件信封
+// event envelope transported between instances.
+type EventEnvelope struct {
+	Id      string `json:"id"`
+	Origin  string `json:"origin"`
+	Event   string `json:"event"`
+	Payload string `json:"payload"`
+
+	// Time 发布时间，Unix 毫秒时间戳
+	// publish time, Unix timestamp in milliseconds.
+	Time int64 `json:"time"`
+}
+
+// EventBusDriver 通过给定的信息获取分布式事件总线，事件通过 ClassSerializer 序列化.
+// Redis 驱动通过 redis.Connection(config["connection"]) 获取 Redis 连接，内存驱动忽略 redis
+// Get a distributed event bus with the given information, events are serialized with the ClassSerializer.
+// The Redis driver gets its connection through redis.Connection(config["connection"]), the in-memory driver ignores redis.
+type EventBusDriver func(name string, config Fields, redis RedisFactory, serializer ClassSerializer) EventBus
+
+type EventBusFactory interface {
+	// Bus 按名称获取分布式事件总线
+	// Get a distributed event bus by name.
+	Bus(name ...string) EventBus
+
+	// Extend 添加分布式事件总线驱动
+	// Add a distributed event bus driver.
+	Extend(driver string, busDriver EventBusDriver)
+}
+
+// EventBus 分布式事件总线，事件通过 ClassSerializer 序列化后发布，远程实例解码后交给本地监听器
+// distributed event bus, events are serialized with the ClassSerializer and published,
+// remote instances decode them and hand them to local listeners.
+type EventBus interface {
+	// InstanceId 获取当前进程的标识，作为 EventEnvelope.Origin，用于抑制自己发布的事件，每次启动都可以不同
+	// get the identifier of the current process, used as EventEnvelope.Origin to suppress events it published itself,
+	// it may differ on every start.
+	InstanceId() string
+
+	// ConsumerGroup 获取 AtLeastOnce 使用的消费组名称，来自配置 group，在重启后保持不变，
+	// 同一时刻不能有两个实例使用相同的名称
+	// get the consumer group name used by AtLeastOnce, taken from the group config and stable across restarts,
+	// no two running instances may share it.
+	ConsumerGroup() string
+
+	// Publish 发布事件
+	// publish an event.
+	Publish(event Event) error
+
+	// Listen 监听远程事件并交给给定的本地调度程序，直到上下文结束.
+	// Origin 不等于 InstanceId() 的事件只通过 EventDispatcher.DispatchLocal 分发，不会再次发布，避免在实例间循环广播
+	// listen for remote events and hand them to the given local dispatcher, until the context is done.
+	// Events whose Origin differs from InstanceId() are only dispatched through EventDispatcher.DispatchLocal
+	// and never republished, so they do not loop between instances.
+	Listen(ctx context.Context, dispatcher EventDispatcher) error
+
+	// Delivery 获取送达保证
+	// get the delivery guarantee.
+	Delivery() EventDelivery
+
+	// Close 关闭事件总线
+	// close the event bus.
+	Close() error
+}
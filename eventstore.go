@@ -0,0 +1,164 @@
+package contracts
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// AnyVersion 追加时不检查流的版本
+	// do not check the stream version when appending.
+	AnyVersion int64 = -1
+
+	// NoStream 追加时要求流不存在
+	// require the stream not to exist when appending.
+	NoStream int64 = 0
+)
+
+// StoredEvent 已持久化的事件
+// persisted event.
+type StoredEvent struct {
+	StreamId string
+
+	// Version 事件在流中的版本，从 1 开始
+	// version of the event within the stream, starting at 1.
+	Version int64
+
+	// Position 事件的全局顺序
+	// global order of the event.
+	Position int64
+
+	Event      Event
+	Metadata   Fields
+	RecordedAt time.Time
+}
+
+// EventSnapshot 聚合快照
+// aggregate snapshot.
+type EventSnapshot struct {
+	StreamId string
+	Version  int64
+
+	// State 由 SnapshotAggregate.Snapshot 序列化的状态，存储驱动原样保存
+	// state serialized by SnapshotAggregate.Snapshot, stored verbatim by the store driver.
+	State string
+
+	TakenAt time.Time
+}
+
+// EventStoreDriver 通过给定的信息获取事件存储，事件通过 ClassSerializer 序列化.
+// SQL 驱动通过 db.Connection(config["connection"]) 获取数据库连接，内存驱动忽略 db
+// Get an event store with the given information, events are serialized with the ClassSerializer.
+// The SQL driver gets its connection through db.Connection(config["connection"]), the in-memory driver ignores db.
+type EventStoreDriver func(name string, config Fields, db DBFactory, serializer ClassSerializer) EventStore
+
+type EventStoreFactory interface {
+	// Store 按名称获取事件存储
+	// Get an event store by name.
+	Store(name ...string) EventStore
+
+	// Extend 添加事件存储驱动
+	// Add an event store driver.
+	Extend(driver string, storeDriver EventStoreDriver)
+}
+
+type EventStore interface {
+	// Append 追加事件到给定的流，流的当前版本与 expectedVersion 不一致时返回 ConcurrencyError
+	// append events to the given stream, returning ConcurrencyError when the current version
+	// of the stream does not match expectedVersion.
+	Append(streamId string, expectedVersion int64, events ...Event) (int64, Exception)
+
+	// ReadStream 读取给定流中版本大于 fromVersion 的事件
+	// read the events of the given stream with a version greater than fromVersion.
+	ReadStream(streamId string, fromVersion int64) ([]StoredEvent, Exception)
+
+	// ReadAll 按全局顺序读取位置大于 fromPosition 的事件
+	// read events with a position greater than fromPosition in global order.
+	ReadAll(fromPosition int64, limit int) ([]StoredEvent, Exception)
+
+	// SaveSnapshot 保存快照
+	// save a snapshot.
+	SaveSnapshot(snapshot EventSnapshot) Exception
+
+	// LoadSnapshot 加载给定流的最新快照，不存在时返回 nil, nil
+	// load the latest snapshot of the given stream, returning nil, nil when there is none.
+	LoadSnapshot(streamId string) (*EventSnapshot, Exception)
+}
+
+// ConcurrencyError 追加事件时版本冲突
+// version conflict when appending events.
+type ConcurrencyError struct {
+	StreamId string
+	Expected int64
+	Actual   int64
+}
+
+func (err ConcurrencyError) Error() string {
+	return fmt.Sprintf("event store: stream %s is at version %d, expected %d", err.StreamId, err.Actual, err.Expected)
+}
+
+func (err ConcurrencyError) GetPrevious() Exception {
+	return nil
+}
+
+// Aggregate 通过重放事件重建状态的聚合
+// aggregate whose state is rebuilt by replaying events.
+type Aggregate interface {
+	// AggregateId 获取聚合 ID，即事件流 ID
+	// get the aggregate ID, which is the event stream ID.
+	AggregateId() string
+
+	// Version 获取最后一次持久化的版本，不包括 PendingEvents() 中的事件，Record 不会增加该版本
+	// get the last persisted version, excluding the events in PendingEvents(), Record does not increment it.
+	Version() int64
+
+	// Apply 将事件应用到聚合状态，不改变 Version()；重放结束后仓库以最后一个事件的版本调用 Replayed
+	// apply the event to the aggregate state without changing Version();
+	// after replaying, the repository calls Replayed with the version of the last event.
+	Apply(event Event)
+
+	// Record 应用新事件并记录为待保存
+	// apply a new event and record it as pending.
+	Record(event Event)
+
+	// PendingEvents 获取待保存的事件
+	// get the pending events.
+	PendingEvents() []Event
+
+	// ClearPendingEvents 清空待保存的事件
+	// clear the pending events.
+	ClearPendingEvents()
+
+	// Replayed 将版本设置为给定的已持久化版本，在重放结束或保存成功后由仓库调用
+	// set the version to the given persisted version, called by the repository after replaying or saving.
+	Replayed(version int64)
+}
+
+// SnapshotAggregate 支持快照的聚合
+// aggregate that supports snapshots.
+type SnapshotAggregate interface {
+	Aggregate
+
+	// Snapshot 将当前状态序列化为快照
+	// serialize the current state into a snapshot.
+	Snapshot() string
+
+	// Restore 从 Snapshot 序列化的快照恢复状态
+	// restore the state from a snapshot serialized by Snapshot.
+	Restore(state string, version int64)
+}
+
+// AggregateRepository 聚合仓库，加载时从快照开始重放事件，
+// 保存时以 Version() 作为 expectedVersion 追加 PendingEvents()，成功后调用 ClearPendingEvents 并以追加后的版本调用 Replayed
+// aggregate repository, loading replays events starting from the latest snapshot,
+// saving appends PendingEvents() with Version() as expectedVersion,
+// then calls ClearPendingEvents and Replayed with the version after the append.
+type AggregateRepository[T Aggregate] interface {
+	// Load 加载给定 ID 的聚合
+	// load the aggregate with the given ID.
+	Load(id string) (T, Exception)
+
+	// Save 保存聚合的待保存事件
+	// save the pending events of the aggregate.
+	Save(aggregate T) Exception
+}
@@ -1,20 +1,212 @@
 package contracts
 
+import (
+	"reflect"
+	"time"
+)
+
 type Exception interface {
 	Error() string
 	GetPrevious() Exception
 }
 
+// HttpException 携带 http 状态码的异常
+// exception carrying an http status code.
+type HttpException interface {
+	Exception
+
+	// StatusCode 获取 http 状态码
+	// get http status code.
+	StatusCode() int
+}
+
+// CodedException 携带机器可读错误码的异常
+// exception carrying a machine-readable error code.
+type CodedException interface {
+	Exception
+
+	// Code 获取错误码，例如 validation_failed
+	// get the error code, such as validation_failed.
+	Code() string
+}
+
+// SafeException 可以展示给用户的异常
+// exception that can be shown to users.
+type SafeException interface {
+	Exception
+
+	// SafeMessage 获取对用户安全的错误信息
+	// get the user-safe error message.
+	SafeMessage() string
+}
+
+// ContextualException 携带结构化上下文的异常
+// exception carrying structured context.
+type ContextualException interface {
+	Exception
+	FieldsProvider
+}
+
+// StackFrame 调用栈帧
+// call stack frame.
+type StackFrame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// TraceableException 捕获了调用栈的异常
+// exception that captured the call stack.
+type TraceableException interface {
+	Exception
+
+	// StackTrace 获取创建异常时的调用栈
+	// get the call stack at the time the exception was created.
+	StackTrace() []StackFrame
+}
+
+// PanicException 由 panic 转换而来的异常
+// exception converted from a panic.
+type PanicException interface {
+	TraceableException
+
+	// Recovered 获取 recover() 返回的原始值
+	// get the original value returned by recover().
+	Recovered() any
+}
+
+// RecoverySource 恢复边界的来源
+// source of the recovery boundary.
+type RecoverySource string
+
+const (
+	RecoverySourceGoroutine RecoverySource = "goroutine"
+	RecoverySourceJob       RecoverySource = "job"
+	RecoverySourceListener  RecoverySource = "listener"
+	RecoverySourceSchedule  RecoverySource = "schedule"
+	RecoverySourceWebSocket RecoverySource = "websocket"
+)
+
+// RecoveryBoundary 恢复边界，将 panic 转换为带调用栈的 PanicException 并交给 ExceptionHandler 处理
+// recovery boundary, converting panics into PanicException with stack traces and routing them to the ExceptionHandler.
+type RecoveryBoundary interface {
+	// Run 执行给定的函数，发生 panic 时返回转换后的异常，否则返回 nil
+	// run the given function, returning the converted exception on panic, otherwise nil.
+	Run(source RecoverySource, fn func()) Exception
+
+	// Go 在新的 goroutine 中执行给定的函数
+	// run the given function in a new goroutine.
+	Go(source RecoverySource, fn func())
+}
+
+// ExceptionRenderer 将异常渲染为 http 响应
+// render the exception as an http response.
+type ExceptionRenderer func(exception Exception) HttpResponse
+
+// 异常上报上下文的常用键
+// common keys of the exception report context.
+const (
+	ExceptionContextRequest = "request"
+	ExceptionContextUserId  = "user_id"
+	ExceptionContextJobUuid = "job_uuid"
+)
+
+// ExceptionReport 待上报的异常
+// exception to be reported.
+type ExceptionReport struct {
+	Exception Exception
+
+	// Fingerprint 根据异常类型和调用栈计算的指纹，用于去重
+	// fingerprint computed from the exception type and stack, used for deduplication.
+	Fingerprint string
+
+	// Context 上报上下文，例如请求、Guard 中的用户 ID、任务 UUID
+	// report context, such as the request, the user ID from Guard and the job UUID.
+	Context Fields
+
+	// Suppressed 在去重窗口内被合并的重复次数.
+	// 首次出现时立即上报，值为 0；窗口关闭时如果存在重复，会以最后一次的异常再上报一次，值为窗口内的重复次数
+	// number of duplicates merged within the deduplication window.
+	// The first occurrence is reported immediately with 0; when the window closes with duplicates,
+	// the last exception is reported again with the number of duplicates within the window.
+	Suppressed int
+
+	Time time.Time
+}
+
+// ExceptionReporter 异常上报器，例如日志、文件和 webhook
+// exception reporter, such as log, file and webhook.
+type ExceptionReporter interface {
+	// Report 上报异常
+	// report exception.
+	Report(report ExceptionReport) error
+}
+
+// ExceptionReporterDriver 通过给定的配置获取异常上报器
+// Get an exception reporter with the given configuration.
+type ExceptionReporterDriver func(name string, config Fields) ExceptionReporter
+
+// ExceptionContextProvider 为异常提供上报上下文
+// provide report context for the exception.
+type ExceptionContextProvider func(exception Exception) Fields
+
+type ExceptionReporterFactory interface {
+	// Extend 扩展异常上报器驱动
+	// extend exception reporter driver.
+	Extend(driver string, reporterDriver ExceptionReporterDriver)
+
+	// Reporter 按名称获取异常上报器，未指定名称时使用配置 exceptions.default 中的上报器
+	// get the exception reporter by name, the one in the exceptions.default config is used when no name is given.
+	Reporter(name ...string) ExceptionReporter
+
+	// UseContextProvider 注册上报上下文提供者
+	// register a report context provider.
+	UseContextProvider(provider ExceptionContextProvider)
+
+	// DeduplicateWithin 设置去重窗口，为 0 时不去重，默认读取配置 exceptions.dedup_window
+	// set the deduplication window, 0 disables deduplication, read from the exceptions.dedup_window config by default.
+	DeduplicateWithin(window time.Duration)
+
+	// Fingerprint 计算给定异常的指纹
+	// compute the fingerprint of the given exception.
+	Fingerprint(exception Exception) string
+
+	// Report 将异常分发给配置 exceptions.reporters 中列出的所有上报器，相同指纹的异常在去重窗口内只上报一次
+	// fan the exception out to every reporter listed in the exceptions.reporters config,
+	// exceptions with the same fingerprint are reported once within the deduplication window.
+	Report(exception Exception)
+}
+
 type ExceptionHandler interface {
 	// Handle 处理异常
 	// Handle the exception, and return the specified result.
 	Handle(exception Exception) any
 
-	// ShouldReport 判断是否需要上报
-	// Determine whether to report.
+	// ShouldReport 判断是否需要上报，DontReport 中的异常类型不会上报
+	// Determine whether to report, exception types in DontReport are not reported.
 	ShouldReport(exception Exception) bool
 
 	// Report 上报异常
 	// report exception.
 	Report(exception Exception)
+
+	// ReporterFactory 获取异常上报器工厂，Report 通过 ExceptionReporterFactory.Report 分发
+	// get the exception reporter factory, Report fans out through ExceptionReporterFactory.Report.
+	ReporterFactory() ExceptionReporterFactory
+
+	// RegisterRenderer 为给定类型注册渲染器.
+	// 具体类型按动态类型精确匹配，*NotFound 与 NotFound 是不同的类型；
+	// 接口类型（例如 reflect.TypeOf((*HttpException)(nil)).Elem()）匹配所有实现该接口的异常.
+	// Handle 时先匹配具体类型，再按注册顺序匹配接口类型，
+	// 没有匹配时按 HttpException、CodedException、SafeException 渲染默认响应
+	// Register a renderer for the given type.
+	// Concrete types match the dynamic type exactly, *NotFound and NotFound are distinct types;
+	// interface types (e.g. reflect.TypeOf((*HttpException)(nil)).Elem()) match every exception implementing them.
+	// Handle tries concrete types first, then interface types in registration order,
+	// falling back to a default response built from HttpException, CodedException and SafeException.
+	RegisterRenderer(typ reflect.Type, renderer ExceptionRenderer)
+
+	// DontReport 设置不需要上报的异常类型，匹配规则与 RegisterRenderer 相同
+	// set the exception types that should not be reported, matched as in RegisterRenderer.
+	DontReport(types ...reflect.Type)
 }
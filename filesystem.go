@@ -40,6 +40,8 @@ type FileSystemFactory interface {
 }
 
 type FileSystem interface {
+	Pingable
+
 	// Name 从文件路径中提取文件名
 	// Extract the file name from a file path.
 	Name() string
@@ -0,0 +1,118 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// HealthStatus 健康状态
+// health status.
+type HealthStatus string
+
+const (
+	HealthUp       HealthStatus = "up"
+	HealthDegraded HealthStatus = "degraded"
+	HealthDown     HealthStatus = "down"
+)
+
+// HealthProbe 探针类型
+// probe type.
+type HealthProbe string
+
+const (
+	LivenessProbe  HealthProbe = "liveness"
+	ReadinessProbe HealthProbe = "readiness"
+)
+
+type HealthCheck interface {
+	// Name 获取检查名称
+	// get check name.
+	Name() string
+
+	// Timeout 获取检查的超时时间
+	// get the timeout of the check.
+	Timeout() time.Duration
+
+	// Critical 判断是否关键检查，关键检查失败时整体状态为 down，否则为 degraded
+	// Determine whether the check is critical, the overall status is down when a critical check fails, otherwise degraded.
+	Critical() bool
+
+	// Probes 获取该检查参与的探针
+	// get the probes this check participates in.
+	Probes() []HealthProbe
+
+	// Check 执行检查
+	// perform the check.
+	Check(ctx context.Context) error
+}
+
+// 内置检查的名称，对应的服务提供者通过 Pingable 为每个连接注册一个名为 {name}.{connection} 的检查
+// names of the built-in checks, the corresponding service providers register a check named {name}.{connection}
+// for each connection through Pingable.
+const (
+	HealthCheckDatabase   = "database"
+	HealthCheckRedis      = "redis"
+	HealthCheckQueue      = "queue"
+	HealthCheckFileSystem = "filesystem"
+)
+
+// Pingable 可以探测连通性的连接，DBConnection、RedisConnection、Queue、FileSystem 都实现了该接口，用于内置检查
+// Connection whose connectivity can be probed, DBConnection, RedisConnection, Queue and FileSystem
+// all implement it for the built-in checks.
+type Pingable interface {
+	// Ping 探测连通性
+	// probe connectivity.
+	Ping(ctx context.Context) error
+}
+
+// HealthCheckProvider 提供健康检查的服务提供者，Application.RegisterServices 注册服务时收集其检查并注册到 HealthChecker
+// Service provider that contributes health checks,
+// Application.RegisterServices collects its checks into the HealthChecker when the provider is registered.
+type HealthCheckProvider interface {
+	ServiceProvider
+
+	// HealthChecks 获取该服务提供者提供的健康检查
+	// Get the health checks contributed by this service provider.
+	HealthChecks() []HealthCheck
+}
+
+// HealthCheckResult 单项检查结果
+// single check result.
+type HealthCheckResult struct {
+	Name     string        `json:"name"`
+	Status   HealthStatus  `json:"status"`
+	Critical bool          `json:"critical"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// HealthReport 汇总的健康报告
+// aggregated health report.
+type HealthReport struct {
+	Probe     HealthProbe         `json:"probe"`
+	Status    HealthStatus        `json:"status"`
+	Checks    []HealthCheckResult `json:"checks"`
+	CheckedAt time.Time           `json:"checked_at"`
+}
+
+type HealthChecker interface {
+	// Register 注册健康检查
+	// register health checks.
+	Register(checks ...HealthCheck)
+
+	// Checks 获取所有健康检查
+	// get all health checks.
+	Checks() []HealthCheck
+
+	// Liveness 执行存活检查
+	// perform liveness checks.
+	Liveness(ctx context.Context) HealthReport
+
+	// Readiness 执行就绪检查
+	// perform readiness checks.
+	Readiness(ctx context.Context) HealthReport
+
+	// RegisterRoutes 在给定路由器上注册 {prefix}/live 和 {prefix}/ready 路由
+	// Register {prefix}/live and {prefix}/ready routes on the given router.
+	RegisterRoutes(router Router, prefix string)
+}
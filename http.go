@@ -225,6 +225,10 @@ type HttpRequest interface {
 	OptionalGetter[any]
 	FieldsProvider
 
+	// Scope 获取当前请求的作用域容器，响应完成后释放
+	// get the scoped container of the current request, disposed after the response.
+	Scope() ScopedContainer
+
 	// Only 只获取指定 key 的数据
 	// Get only the data of the specified key.
 	Only(keys ...string) Fields
@@ -1,6 +1,168 @@
 package contracts
 
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LogLevel 日志级别
+// log level.
+type LogLevel int8
+
+const (
+	DebugLevel LogLevel = iota
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+	FatalLevel
+)
+
+func (level LogLevel) String() string {
+	switch level {
+	case DebugLevel:
+		return "debug"
+	case InfoLevel:
+		return "info"
+	case WarnLevel:
+		return "warn"
+	case ErrorLevel:
+		return "error"
+	case FatalLevel:
+		return "fatal"
+	}
+	return "unknown"
+}
+
+func (level LogLevel) MarshalText() ([]byte, error) {
+	return []byte(level.String()), nil
+}
+
+// UnmarshalText 解析日志级别，不区分大小写，warning 视为 warn
+// parse the log level case-insensitively, warning is treated as warn.
+func (level *LogLevel) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "debug":
+		*level = DebugLevel
+	case "info":
+		*level = InfoLevel
+	case "warn", "warning":
+		*level = WarnLevel
+	case "error":
+		*level = ErrorLevel
+	case "fatal":
+		*level = FatalLevel
+	default:
+		return fmt.Errorf("logger: unknown log level %q", text)
+	}
+	return nil
+}
+
+// WithContext 从上下文中提取的字段键
+// field keys extracted from the context by WithContext.
+const (
+	LogRequestIdKey = "request_id"
+	LogTraceIdKey   = "trace_id"
+	LogSpanIdKey    = "span_id"
+)
+
+// LogEntry 日志记录
+// log record.
+type LogEntry struct {
+	Time    time.Time `json:"time"`
+	Level   LogLevel  `json:"level"`
+	Channel string    `json:"channel"`
+	Message string    `json:"message"`
+	Fields  Fields    `json:"fields,omitempty"`
+}
+
+// LogSink 日志输出目标，例如标准输出 JSON、基于 FileSystem 的滚动文件或 syslog 格式
+// log output target, such as stdout JSON, rotating files via FileSystem or syslog format.
+type LogSink interface {
+	// Write 写入日志记录
+	// write a log record.
+	Write(entry LogEntry) error
+
+	// Close 关闭输出目标
+	// close the output target.
+	Close() error
+}
+
+// LogSinkProvider 日志输出目标提供者
+// log sink provider.
+type LogSinkProvider func(config Fields) LogSink
+
+// LogContextExtractor 从上下文中提取日志字段，例如请求 ID 和追踪 ID
+// extract log fields from the context, such as request ID and trace ID.
+type LogContextExtractor func(ctx context.Context) Fields
+
+// LogSamplingPolicy 日志采样策略.
+// 每个采样键先输出前 First 条，之后每 Thereafter 条输出一条；
+// 设置 Limiter 时还需通过令牌桶；被抑制的数量每隔 SummaryInterval 以一条汇总日志输出
+// log sampling policy.
+// The first First records of each sample key are emitted, then every Thereafter-th record;
+// when Limiter is set records must also pass the token bucket; the number of suppressed records
+// is emitted as a summary record every SummaryInterval.
+type LogSamplingPolicy struct {
+	First int
+
+	// Thereafter 为 0 时丢弃前 First 条之后的所有记录；First 和 Thereafter 都为 0 时不按数量采样，只使用 Limiter
+	// when 0, every record after the first First is dropped;
+	// when both First and Thereafter are 0 there is no count-based sampling, only the Limiter applies.
+	Thereafter int
+
+	// Limiter 每个采样键调用一次以创建独立的令牌桶，与 RateLimiter.Limiter 的提供者相同；
+	// 采样器只调用 Limiter.Allow，不允许通行的记录直接被抑制，所以不会阻塞调用方
+	// called once per sample key to create its own token bucket, like the provider of RateLimiter.Limiter;
+	// the sampler only calls Limiter.Allow and suppresses records that are not allowed, so the caller is never blocked.
+	Limiter InstanceProvider[Limiter]
+
+	// SummaryInterval 为 0 时不输出汇总日志
+	// when 0, no summary records are emitted.
+	SummaryInterval time.Duration
+}
+
+type LoggerFactory interface {
+	// Channel 按名称获取日志通道
+	// Get a log channel by name.
+	Channel(name ...string) Logger
+
+	// Extend 扩展日志输出目标驱动
+	// extend log sink driver.
+	Extend(driver string, provider LogSinkProvider)
+
+	// UseContextExtractor 注册上下文字段提取器，WithContext 时会依次调用
+	// register a context field extractor, called in order on WithContext.
+	UseContextExtractor(extractor LogContextExtractor)
+
+	// Sampling 为给定通道和级别设置采样策略
+	// set the sampling policy for the given channel and level.
+	Sampling(channel string, level LogLevel, policy LogSamplingPolicy)
+
+	// ClearSampling 移除给定通道和级别的采样策略
+	// remove the sampling policy of the given channel and level.
+	ClearSampling(channel string, level LogLevel)
+}
+
 type Logger interface {
+	// WithContext 从给定上下文中提取请求 ID、追踪 ID 等字段，分别以 LogRequestIdKey、LogTraceIdKey、LogSpanIdKey 为键
+	// extract fields such as request ID and trace ID from the given context,
+	// under LogRequestIdKey, LogTraceIdKey and LogSpanIdKey.
+	WithContext(ctx context.Context) Logger
+
+	// Level 获取该通道的最低日志级别
+	// get the minimum log level of this channel.
+	Level() LogLevel
+
+	// SetLevel 设置该通道的最低日志级别，低于该级别的日志会被丢弃
+	// set the minimum log level of this channel, records below it are discarded.
+	SetLevel(level LogLevel)
+
+	// WithSampleKey 设置采样键，默认使用消息内容
+	// set the sample key, the message is used by default.
+	WithSampleKey(key string) Logger
+
 	// WithFields 添加数据
 	// adding data
 	WithFields(fields Fields) Logger
@@ -0,0 +1,36 @@
+package contracts
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLogLevelJson(t *testing.T) {
+	bytes, err := json.Marshal(LogEntry{Level: WarnLevel})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]any
+	if err = json.Unmarshal(bytes, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["level"] != "warn" {
+		t.Fatalf("unexpected level %v", fields["level"])
+	}
+
+	var entry LogEntry
+	if err = json.Unmarshal(bytes, &entry); err != nil || entry.Level != WarnLevel {
+		t.Fatalf("unexpected entry %v, %v", entry, err)
+	}
+}
+
+func TestLogLevelUnmarshalText(t *testing.T) {
+	var level LogLevel
+	if err := level.UnmarshalText([]byte("WARNING")); err != nil || level != WarnLevel {
+		t.Fatalf("unexpected level %v, %v", level, err)
+	}
+	if err := level.UnmarshalText([]byte("verbose")); err == nil {
+		t.Fatalf("expected an error for an unknown level")
+	}
+}
@@ -0,0 +1,120 @@
+package contracts
+
+import "io"
+
+// 框架默认埋点的指标名称
+// metric names of the framework default instrumentation.
+const (
+	// MetricHttpRequestDuration 按 Route 统计的 HTTP 请求耗时，标签 method、route、status，
+	// route 为 Route.Path() 返回的路由模板而不是原始路径，与追踪中的 http.route 一致
+	// HTTP request latency per Route, labels method, route, status,
+	// route is the route template returned by Route.Path() rather than the raw path, matching http.route in tracing.
+	MetricHttpRequestDuration = "http_request_duration_seconds"
+
+	// MetricDBQueryDuration 数据库查询耗时，标签 connection
+	// DB query time, labels connection.
+	MetricDBQueryDuration = "db_query_duration_seconds"
+
+	// MetricCacheHits CacheStore 命中次数，标签 store
+	// CacheStore hits, labels store.
+	MetricCacheHits = "cache_hits_total"
+
+	// MetricCacheMisses CacheStore 未命中次数，标签 store
+	// CacheStore misses, labels store.
+	MetricCacheMisses = "cache_misses_total"
+
+	// MetricQueueDepth 通过 Queue.Size 采集的队列深度，标签 connection、queue
+	// queue depth collected through Queue.Size, labels connection, queue.
+	MetricQueueDepth = "queue_depth"
+
+	// MetricJobDuration 任务执行耗时，标签 connection、queue、status
+	// job duration, labels connection, queue, status.
+	MetricJobDuration = "queue_job_duration_seconds"
+)
+
+// MetricLabels 指标标签
+// metric labels.
+type MetricLabels map[string]string
+
+type Counter interface {
+	// Inc 加一
+	// increment by one.
+	Inc()
+
+	// Add 增加给定的非负值
+	// add the given non-negative value.
+	Add(delta float64)
+}
+
+type Gauge interface {
+	// Set 设置当前值
+	// set the current value.
+	Set(value float64)
+
+	// Inc 加一
+	// increment by one.
+	Inc()
+
+	// Dec 减一
+	// decrement by one.
+	Dec()
+
+	// Add 增加给定的值，可以为负数
+	// add the given value, may be negative.
+	Add(delta float64)
+}
+
+type Histogram interface {
+	// Observe 记录一次观测值
+	// record an observation.
+	Observe(value float64)
+}
+
+type CounterVec interface {
+	// With 获取给定标签的计数器
+	// get the counter for the given labels.
+	With(labels MetricLabels) Counter
+}
+
+type GaugeVec interface {
+	// With 获取给定标签的仪表
+	// get the gauge for the given labels.
+	With(labels MetricLabels) Gauge
+}
+
+type HistogramVec interface {
+	// With 获取给定标签的直方图
+	// get the histogram for the given labels.
+	With(labels MetricLabels) Histogram
+}
+
+type Metrics interface {
+	// Counter 注册或获取带标签的计数器
+	// register or get a labeled counter.
+	Counter(name, help string, labels ...string) CounterVec
+
+	// Gauge 注册或获取带标签的仪表
+	// register or get a labeled gauge.
+	Gauge(name, help string, labels ...string) GaugeVec
+
+	// Histogram 注册或获取带标签的直方图，buckets 为空时使用默认分桶
+	// register or get a labeled histogram, default buckets are used when buckets is empty.
+	Histogram(name, help string, buckets []float64, labels ...string) HistogramVec
+
+	// WritePrometheus 以 Prometheus 文本格式输出所有指标
+	// write all metrics in the Prometheus text format.
+	WritePrometheus(writer io.Writer) error
+
+	// RegisterRoutes 在给定路由器上注册输出 Prometheus 文本格式的路由
+	// register a route serving the Prometheus text format on the given router.
+	RegisterRoutes(router Router, path string)
+}
+
+// Measurable 可以自动记录指标的组件，Router、DBConnection、CacheStore、Queue 和 QueueWorker 都实现了该接口
+// component that records metrics automatically, Router, DBConnection, CacheStore, Queue and QueueWorker
+// all implement it.
+type Measurable interface {
+	// UseMetrics 使用给定的指标注册表，为 nil 时不记录指标
+	// use the given metrics registry, no metrics are recorded when nil.
+	UseMetrics(metrics Metrics)
+}
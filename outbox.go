@@ -0,0 +1,116 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+// OutboxMessage 发件箱中的消息
+// message in the outbox.
+type OutboxMessage struct {
+	Id      int64  `db:"id"`
+	Event   string `db:"event"`
+	Payload string `db:"payload"`
+
+	// Connection 队列连接名称，来自 OutboxEvent.ViaConnection，为空时转发到 EventDispatcher
+	// queue connection name, taken from OutboxEvent.ViaConnection, relayed to the EventDispatcher when empty.
+	Connection string `db:"connection"`
+
+	// Queue 队列名称，来自 OutboxEvent.ViaQueue，为空时使用连接的默认队列
+	// queue name, taken from OutboxEvent.ViaQueue, the default queue of the connection is used when empty.
+	Queue string `db:"queue"`
+
+	Attempts     int        `db:"attempts"`
+	CreatedAt    time.Time  `db:"created_at"`
+	ClaimedUntil *time.Time `db:"claimed_until"`
+	DispatchedAt *time.Time `db:"dispatched_at"`
+
+	// DeadAt 转发失败次数达到上限的时间，不为空时不会再被认领
+	// time at which the message reached the maximum attempts, it is no longer claimed when set.
+	DeadAt *time.Time `db:"dead_at"`
+}
+
+// OutboxEvent 指定转发目标的事件，未实现该接口的事件转发到 EventDispatcher
+// event that chooses its relay destination, events not implementing it are relayed to the EventDispatcher.
+type OutboxEvent interface {
+	Event
+
+	// ViaConnection 获取队列连接名称，为空时转发到 EventDispatcher
+	// get the queue connection name, relayed to the EventDispatcher when empty.
+	ViaConnection() string
+
+	// ViaQueue 获取队列名称
+	// get the queue name.
+	ViaQueue() string
+}
+
+// TxEventDispatcher 绑定到事务的事件调度器，事件写入发件箱表，事务提交后由 OutboxRelay 转发，事务回滚时随之丢弃
+// event dispatcher bound to a transaction, events are written to the outbox table,
+// relayed by the OutboxRelay after the transaction commits, and discarded when it rolls back.
+type TxEventDispatcher interface {
+	// Dispatch 将事件写入发件箱表，返回异常时调用方应回滚事务
+	// write the event to the outbox table, the caller should roll back the transaction when an exception is returned.
+	Dispatch(event Event) Exception
+}
+
+// Outbox 事务发件箱，事件与业务数据通过同一个 SqlExecutor 写入发件箱表，事务回滚时随之丢弃
+// transactional outbox, events are written to the outbox table through the same SqlExecutor as the business data,
+// and are discarded along with it when the transaction rolls back.
+type Outbox interface {
+	// Store 通过给定的执行器将事件写入发件箱表，通常传入 DBTx；返回异常时调用方应回滚事务
+	// write the events to the outbox table through the given executor, usually a DBTx;
+	// the caller should roll back the transaction when an exception is returned.
+	Store(executor SqlExecutor, events ...Event) Exception
+
+	// Dispatcher 获取绑定到给定执行器的事件调度器，在 DBConnection.Transaction 中应使用它代替 EventDispatcher
+	// get an event dispatcher bound to the given executor, to be used instead of the EventDispatcher
+	// within DBConnection.Transaction.
+	Dispatcher(executor SqlExecutor) TxEventDispatcher
+
+	// Claim 按 Id 升序（即写入顺序）认领最多 limit 条尚未转发、未进入死信且未被认领（或认领已过期）的消息，
+	// 并将其 ClaimedUntil 设置为当前时间加 lease.
+	// 认领期间并发的转发器不会认领同一条消息，具体的加锁方式由驱动决定.
+	// 同一个转发器按返回顺序转发，多个转发器之间不保证顺序
+	// claim up to limit messages that have not been relayed, are not dead and are not claimed (or whose claim expired)
+	// in ascending Id order, which is the order they were stored in, setting their ClaimedUntil to now plus lease.
+	// Concurrent relays never claim the same message while the claim holds, how rows are locked is up to the driver.
+	// A relay relays messages in the returned order, no order is guaranteed across relays.
+	Claim(limit int, lease time.Duration) ([]OutboxMessage, Exception)
+
+	// MarkDispatched 将消息标记为已转发
+	// mark messages as relayed.
+	MarkDispatched(ids ...int64) Exception
+
+	// MarkFailed 记录一次转发失败并释放认领，Attempts 达到配置 outbox.max_attempts（默认 5）时设置 DeadAt 进入死信
+	// record a relay failure and release the claim, the message becomes dead by setting DeadAt
+	// once Attempts reaches the outbox.max_attempts config (5 by default).
+	MarkFailed(id int64, err error) Exception
+
+	// Dead 获取最多 limit 条死信消息
+	// get up to limit dead messages.
+	Dead(limit int) ([]OutboxMessage, Exception)
+
+	// Retry 清空给定死信消息的 DeadAt 和 Attempts，使其重新被认领
+	// clear DeadAt and Attempts of the given dead messages so that they are claimed again.
+	Retry(ids ...int64) Exception
+}
+
+// OutboxRelay 将发件箱中的消息转发到 EventDispatcher 或 Queue，保证至少一次送达
+// relays outbox messages to the EventDispatcher or Queue, with at-least-once delivery.
+type OutboxRelay interface {
+	// Work 开始转发
+	// start relaying.
+	Work()
+
+	// Notify 通知有新提交的消息，通常在 DBTx 提交后调用
+	// notify about newly committed messages, usually called after a DBTx commits.
+	Notify()
+
+	// Stop 停止转发
+	// stop relaying.
+	Stop()
+
+	// Shutdown 在给定上下文的截止时间内停止转发，等待进行中的消息完成
+	// stop relaying within the deadline of the given context, waiting for in-flight messages to finish.
+	Shutdown(ctx context.Context) error
+}
@@ -1,6 +1,9 @@
 package contracts
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 type QueueFactory interface {
 	// Connection 解析队列连接实例
@@ -25,10 +28,20 @@ type Ack func()
 type QueueDriver func(name string, config Fields, serializer JobSerializer) Queue
 
 type Queue interface {
+	Pingable
+	Traceable
+	Measurable
+
 	// Push 一个新工作进入队列
 	// a new job onto the queue.
 	Push(job Job, queue ...string) error
 
+	// PushWithContext 一个新工作进入队列，通过 Tracer.Inject 将给定上下文中的追踪上下文写入作业选项，
+	// 并创建 SpanQueuePush span
+	// a new job onto the queue, the trace context in the given context is written to the job options
+	// through Tracer.Inject, and a SpanQueuePush span is created.
+	PushWithContext(ctx context.Context, job Job, queue ...string) error
+
 	// PushOn 将新作业推送到队列中
 	// push a new job onto the queue.
 	PushOn(queue string, job Job) error
@@ -45,10 +58,18 @@ type Queue interface {
 	// push a new job onto the queue after a delay.
 	LaterOn(queue string, delay time.Time, job Job) error
 
+	// LaterWithContext 延迟后将新作业推送到队列中，追踪上下文的处理与 PushWithContext 相同
+	// push a new job onto the queue after a delay, the trace context is handled as in PushWithContext.
+	LaterWithContext(ctx context.Context, delay time.Time, job Job, queue ...string) error
+
 	// GetConnectionName 获取队列的连接名称
 	// Get the connection name for the queue.
 	GetConnectionName() string
 
+	// Size 获取给定队列中等待执行的作业数量，包括延迟作业
+	// Get the number of jobs waiting in the given queue, including delayed jobs.
+	Size(queue ...string) (int64, error)
+
 	// Release 将作业释放回队列中。接受以秒为单位指定的延迟
 	// release the job back into the queue.
 	// Accepts a delay specified in seconds.
@@ -69,12 +90,12 @@ type Job interface {
 	// Get the UUID of the job.
 	Uuid() string
 
-	// GetOptions 获取作业的解码主体
-	// Get the decoded body of the job.
+	// GetOptions 获取作业的解码主体，包括 TraceparentKey 和 TracestateKey 携带的追踪上下文
+	// Get the decoded body of the job, including the trace context carried under TraceparentKey and TracestateKey.
 	GetOptions() Fields
 
-	// Handle 执行工作
-	// the job.
+	// Handle 执行工作，panic 会被 RecoveryBoundary 捕获，作业标记为失败后 worker 继续运行
+	// the job, panics are caught by the RecoveryBoundary, the job is marked as failed and the worker keeps going.
 	Handle()
 
 	// IsReleased 确定作业是否被释放回队列
@@ -139,13 +160,21 @@ type Job interface {
 }
 
 type QueueWorker interface {
-	// Work 执行工作
-	// perform work.
+	Traceable
+	Measurable
+
+	// Work 执行工作，每个作业执行前通过 Tracer.Extract 从 GetOptions() 中读取追踪上下文，并创建 SpanQueueHandle span
+	// perform work, before each job is handled the trace context is read from GetOptions() through Tracer.Extract
+	// and a SpanQueueHandle span is created.
 	Work()
 
 	// Stop 停止工作
 	// stop working.
 	Stop()
+
+	// Shutdown 停止接收新任务，并在给定上下文的截止时间内等待进行中的任务完成
+	// stop accepting new jobs and wait for in-flight jobs to finish within the deadline of the given context.
+	Shutdown(ctx context.Context) error
 }
 
 type JobSerializer interface {
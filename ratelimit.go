@@ -6,6 +6,11 @@ type Limiter interface {
 	// Take 获取下一次通行时间
 	// Get next pass time.
 	Take() time.Time
+
+	// Allow 判断当前是否可以通行，只在可以通行时消耗令牌，不会阻塞也不会预留之后的通行时间
+	// Determine whether a pass is allowed now, a token is consumed only when it is,
+	// it never blocks and never reserves a later pass time.
+	Allow() bool
 }
 
 type RateLimiter interface {
@@ -62,6 +62,8 @@ type RedisSubscribeFunc func(message, channel string)
 
 type RedisConnection interface {
 	RedisConnectionCtx
+	Pingable
+
 	// Subscribe 订阅一组给定的消息频道
 	// subscribe to a set of given channels for messages.
 	Subscribe(channels []string, closure RedisSubscribeFunc) error
@@ -658,6 +660,8 @@ type RedisConnection interface {
 }
 
 type RedisConnectionCtx interface {
+	Traceable
+
 	// SubscribeWithContext 订阅一组给定的消息频道
 	// SubscribeWithContext to a set of given channels for messages.
 	SubscribeWithContext(ctx context.Context, channels []string, closure RedisSubscribeFunc) error
@@ -1,5 +1,7 @@
 package contracts
 
+import "context"
+
 type Route interface {
 	// Middlewares 获取附加到路由的中间件
 	// Get the middlewares attached to the route.
@@ -65,6 +67,9 @@ type RouteGroup interface {
 }
 
 type Router interface {
+	Traceable
+	Measurable
+
 	Static(path string, directory string)
 	// Get 向路由器注册一个新的 GET 路由。
 	// Register a new GET route with the router.
@@ -109,4 +114,11 @@ type Router interface {
 	// Close 关闭 httpserver
 	// close httpserver.
 	Close() error
+
+	// Shutdown 停止接收新连接，并在给定上下文的截止时间内等待进行中的请求和连接完成.
+	// 被劫持的 websocket 连接不在此列，需要通过 WebSocket.Shutdown 关闭
+	// stop accepting new connections and wait for in-flight requests and connections
+	// to finish within the deadline of the given context.
+	// Hijacked websocket connections are not covered, they are closed through WebSocket.Shutdown.
+	Shutdown(ctx context.Context) error
 }
@@ -2,6 +2,7 @@ package contracts
 
 import (
 	"reflect"
+	"time"
 )
 
 type Context interface {
@@ -59,6 +60,27 @@ type Getter[T any] interface {
 	GetFloat64(key string) float64
 	GetFloat(key string) float32
 	GetBool(key string) bool
+
+	// GetDuration 获取时长，支持 time.ParseDuration 格式的字符串，不带单位的数字按秒计算
+	// get a duration, supports time.ParseDuration strings, numbers without a unit are in seconds.
+	GetDuration(key string) time.Duration
+
+	// GetTime 按给定的格式获取时间
+	// get a time with the given layout.
+	GetTime(key string, layout string) time.Time
+
+	// GetStringSlice 获取字符串切片，字符串值按逗号分隔
+	// get a string slice, string values are split by commas.
+	GetStringSlice(key string) []string
+
+	// GetFields 获取嵌套的字段
+	// get nested fields.
+	GetFields(key string) Fields
+
+	// GetBytesSize 获取字节数，支持 10KB、5MiB 之类的字符串，KB、MB、GB 按 1000 进制，KiB、MiB、GiB 按 1024 进制
+	// get the number of bytes, supports strings like 10KB and 5MiB,
+	// KB, MB and GB are powers of 1000, KiB, MiB and GiB are powers of 1024.
+	GetBytesSize(key string) int64
 }
 
 type OptionalGetter[T any] interface {
@@ -77,4 +99,9 @@ type OptionalGetter[T any] interface {
 	Float64Optional(key string, defaultValue float64) float64
 	FloatOptional(key string, defaultValue float32) float32
 	BoolOptional(key string, defaultValue bool) bool
+	DurationOptional(key string, defaultValue time.Duration) time.Duration
+	TimeOptional(key string, layout string, defaultValue time.Time) time.Time
+	StringSliceOptional(key string, defaultValue []string) []string
+	FieldsOptional(key string, defaultValue Fields) Fields
+	BytesSizeOptional(key string, defaultValue int64) int64
 }
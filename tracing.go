@@ -0,0 +1,190 @@
+package contracts
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// TraceparentKey W3C traceparent 的键，用于 HTTP 头和 Job.GetOptions()
+	// key of the W3C traceparent, used in HTTP headers and Job.GetOptions().
+	TraceparentKey = "traceparent"
+
+	// TracestateKey W3C tracestate 的键
+	// key of the W3C tracestate.
+	TracestateKey = "tracestate"
+)
+
+// SpanKind span 类型
+// span kind.
+type SpanKind string
+
+const (
+	SpanInternal SpanKind = "internal"
+	SpanServer   SpanKind = "server"
+	SpanClient   SpanKind = "client"
+	SpanProducer SpanKind = "producer"
+	SpanConsumer SpanKind = "consumer"
+)
+
+// SpanStatus span 状态
+// span status.
+type SpanStatus string
+
+const (
+	SpanUnset SpanStatus = "unset"
+	SpanOk    SpanStatus = "ok"
+	SpanError SpanStatus = "error"
+)
+
+// SpanContext 遵循 W3C traceparent 语义的追踪上下文
+// trace context following W3C traceparent semantics.
+type SpanContext struct {
+	// TraceId 32 位十六进制字符串
+	// 32 hex characters.
+	TraceId string
+
+	// SpanId 16 位十六进制字符串
+	// 16 hex characters.
+	SpanId string
+
+	Sampled    bool
+	TraceState string
+	Remote     bool
+}
+
+// TraceCarrier 追踪上下文的载体，例如 HTTP 头或 Job 选项
+// carrier of the trace context, such as HTTP headers or job options.
+type TraceCarrier interface {
+	// Get 获取给定键的值
+	// get the value of the given key.
+	Get(key string) string
+
+	// Set 设置给定键的值
+	// set the value of the given key.
+	Set(key string, value string)
+}
+
+type Span interface {
+	// Context 获取 span 的追踪上下文
+	// get the trace context of the span.
+	Context() SpanContext
+
+	// SetAttributes 设置属性
+	// set attributes.
+	SetAttributes(attributes Fields)
+
+	// AddEvent 添加事件
+	// add an event.
+	AddEvent(name string, attributes ...Fields)
+
+	// RecordException 记录异常并将状态设置为 SpanError
+	// record an exception and set the status to SpanError.
+	RecordException(exception Exception)
+
+	// SetStatus 设置状态
+	// set status.
+	SetStatus(status SpanStatus, description string)
+
+	// End 结束 span
+	// end the span.
+	End()
+}
+
+type Tracer interface {
+	// Start 创建一个 span，给定上下文中存在 span 时作为其子 span
+	// create a span, as a child of the span in the given context if any.
+	Start(ctx context.Context, name string, kind SpanKind, attributes ...Fields) (context.Context, Span)
+
+	// SpanFromContext 获取给定上下文中的 span
+	// get the span in the given context.
+	SpanFromContext(ctx context.Context) (Span, bool)
+
+	// Inject 将给定上下文中的追踪上下文写入载体
+	// write the trace context in the given context into the carrier.
+	Inject(ctx context.Context, carrier TraceCarrier)
+
+	// Extract 从载体中读取追踪上下文
+	// read the trace context from the carrier.
+	Extract(ctx context.Context, carrier TraceCarrier) context.Context
+}
+
+// SpanData 已结束的 span 数据
+// data of an ended span.
+type SpanData struct {
+	Name       string
+	Kind       SpanKind
+	Context    SpanContext
+	ParentId   string
+	Status     SpanStatus
+	StatusText string
+	Attributes Fields
+	Events     []SpanEvent
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// SpanEvent span 中的事件
+// event in a span.
+type SpanEvent struct {
+	Name       string
+	Attributes Fields
+	Time       time.Time
+}
+
+type SpanExporter interface {
+	// Export 导出已结束的 span
+	// export ended spans.
+	Export(ctx context.Context, spans []SpanData) error
+
+	// Shutdown 关闭导出器
+	// shut down the exporter.
+	Shutdown(ctx context.Context) error
+}
+
+// SpanRecorder 内存中的导出器，用于在测试中断言 span
+// in-memory exporter, used to assert spans in tests.
+type SpanRecorder interface {
+	SpanExporter
+
+	// Spans 获取已记录的所有 span
+	// get all recorded spans.
+	Spans() []SpanData
+
+	// Reset 清空已记录的 span
+	// clear the recorded spans.
+	Reset()
+}
+
+// Traceable 可以自动记录 span 的组件，Router、DBConnection、RedisConnectionCtx、Queue 和 QueueWorker 都实现了该接口
+// component that records spans automatically, Router, DBConnection, RedisConnectionCtx, Queue and QueueWorker
+// all implement it.
+type Traceable interface {
+	// UseTracer 使用给定的追踪器，为 nil 时不记录 span
+	// use the given tracer, no spans are recorded when nil.
+	UseTracer(tracer Tracer)
+}
+
+// 自动记录的 span 名称
+// names of the automatically recorded spans.
+const (
+	// SpanHttpRequest Router 处理的请求，SpanServer 类型，属性 http.method、http.route、http.status_code
+	// request handled by the Router, of SpanServer kind, attributes http.method, http.route, http.status_code.
+	SpanHttpRequest = "http.request"
+
+	// SpanDBQuery SqlExecutor 执行的查询，SpanClient 类型，属性 db.system、db.connection、db.statement
+	// query executed by the SqlExecutor, of SpanClient kind, attributes db.system, db.connection, db.statement.
+	SpanDBQuery = "db.query"
+
+	// SpanRedisCommand RedisConnectionCtx 执行的命令，SpanClient 类型，属性 db.system、db.connection、db.statement
+	// command executed by the RedisConnectionCtx, of SpanClient kind, attributes db.system, db.connection, db.statement.
+	SpanRedisCommand = "redis.command"
+
+	// SpanQueuePush 推送到队列的作业，SpanProducer 类型，属性 messaging.system、messaging.destination、messaging.message_id
+	// job pushed onto the queue, of SpanProducer kind, attributes messaging.system, messaging.destination, messaging.message_id.
+	SpanQueuePush = "queue.push"
+
+	// SpanQueueHandle QueueWorker 处理的作业，SpanConsumer 类型，属性同 SpanQueuePush
+	// job handled by the QueueWorker, of SpanConsumer kind, same attributes as SpanQueuePush.
+	SpanQueueHandle = "queue.handle"
+)
@@ -1,5 +1,7 @@
 package contracts
 
+import "context"
+
 type WebSocket interface {
 	// Add 添加一个连接，返回 fd
 	// add a connection, return fd.
@@ -16,6 +18,11 @@ type WebSocket interface {
 	// Send 发送消息给指定 fd 的连接
 	// send a message to the connection with the specified fd.
 	Send(fd uint64, message any) error
+
+	// Shutdown 拒绝新的连接，向所有连接发送关闭帧，并在给定上下文的截止时间内等待进行中的 OnMessage 完成后关闭连接
+	// reject new connections, send a close frame to every connection, and close them after in-flight
+	// OnMessage calls finish within the deadline of the given context.
+	Shutdown(ctx context.Context) error
 }
 
 type WebSocketConnection interface {
@@ -69,8 +76,8 @@ type WebSocketController interface {
 	// Can handle some authentication and other operations when connecting.
 	OnConnect(request HttpRequest, fd uint64) error
 
-	// OnMessage 当有新的消息来时执行的操作
-	// What to do when a new message arrives.
+	// OnMessage 当有新的消息来时执行的操作，panic 会被 RecoveryBoundary 捕获，连接保持可用
+	// What to do when a new message arrives, panics are caught by the RecoveryBoundary and the connection stays open.
 	OnMessage(frame WebSocketFrame)
 
 	// OnClose 处理关闭事件